@@ -0,0 +1,70 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+
+	"github.com/frodopackets/CLIve/terraform/test/awsverify"
+)
+
+// TestAIServicesModuleAWSIntegration provisions the module for real and
+// verifies its resources against live AWS APIs, going beyond the Terraform
+// output assertions in TestAIServicesModule. It only runs when
+// awsverify.IntegrationModeEnvVar is set to "true", since it calls real AWS
+// APIs and provisions billable resources.
+func TestAIServicesModuleAWSIntegration(t *testing.T) {
+	awsverify.RequireIntegrationMode(t)
+	t.Parallel()
+
+	awsRegion := "us-east-1"
+	embeddingModel := "amazon.titan-embed-text-v2:0"
+	bedrockModels := []string{"amazon.nova-pro-v1:0", "amazon.nova-lite-v1:0"}
+
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../",
+		Vars: map[string]interface{}{
+			"project_name":                   "test-ai-assistant",
+			"environment":                    "test",
+			"aws_region":                     awsRegion,
+			"vpc_id":                         "vpc-12345678",
+			"private_subnet_ids":             []string{"subnet-12345678", "subnet-87654321"},
+			"lambda_security_group_id":       "sg-12345678",
+			"knowledge_base_embedding_model": embeddingModel,
+			"bedrock_models":                 bedrockModels,
+		},
+		NoColor: true,
+	})
+
+	defer terraform.Destroy(t, terraformOptions)
+	terraform.InitAndApply(t, terraformOptions)
+
+	knowledgeBaseID := terraform.Output(t, terraformOptions, "knowledge_base_id")
+	opensearchCollectionName := terraform.Output(t, terraformOptions, "opensearch_collection_name")
+	s3BucketName := terraform.Output(t, terraformOptions, "knowledge_base_s3_bucket_name")
+	bedrockRoleArn := terraform.Output(t, terraformOptions, "bedrock_knowledge_base_role_arn")
+	lambdaRoleArn := terraform.Output(t, terraformOptions, "lambda_bedrock_role_arn")
+	embeddingModelArn := fmt.Sprintf("arn:aws:bedrock:%s::foundation-model/%s", awsRegion, embeddingModel)
+
+	lambdaModelArns := make([]string, len(bedrockModels))
+	for i, model := range bedrockModels {
+		lambdaModelArns[i] = fmt.Sprintf("arn:aws:bedrock:%s::foundation-model/%s", awsRegion, model)
+	}
+
+	t.Run("KnowledgeBaseUsesRequestedEmbeddingModel", func(t *testing.T) {
+		awsverify.AssertKnowledgeBaseEmbeddingModel(t, awsRegion, knowledgeBaseID, embeddingModelArn)
+	})
+
+	t.Run("OpenSearchCollectionIsActiveVectorSearch", func(t *testing.T) {
+		awsverify.AssertOpenSearchCollectionActive(t, awsRegion, opensearchCollectionName)
+	})
+
+	t.Run("S3BucketPolicyGrantsOnlyBedrockRole", func(t *testing.T) {
+		awsverify.AssertS3BucketPolicyGrantsOnlyPrincipal(t, awsRegion, s3BucketName, bedrockRoleArn)
+	})
+
+	t.Run("LambdaRoleCanInvokeModelsOnly", func(t *testing.T) {
+		awsverify.AssertLambdaRoleCanInvokeModelsOnly(t, awsRegion, lambdaRoleArn, lambdaModelArns)
+	})
+}
@@ -1,23 +1,36 @@
 package test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gruntwork-io/terratest/modules/random"
 	"github.com/gruntwork-io/terratest/modules/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/frodopackets/CLIve/terraform/test/compliance"
 )
 
 func TestAIServicesModule(t *testing.T) {
 	t.Parallel()
 
+	// Generate a random suffix so this run doesn't collide with other
+	// concurrent runs on globally-unique names (S3 bucket, OpenSearch
+	// collection).
+	uniqueID := random.UniqueId()
+	projectName := fmt.Sprintf("test-ai-%s", strings.ToLower(uniqueID))
+
 	// Define the Terraform options
-	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+	terraformOptions := &terraform.Options{
 		// Path to the Terraform code that will be tested
 		TerraformDir: "../",
 
 		// Variables to pass to the Terraform code using -var options
 		Vars: map[string]interface{}{
-			"project_name":             "test-ai-assistant",
+			"project_name":             projectName,
 			"environment":              "test",
 			"aws_region":               "us-east-1",
 			"vpc_id":                   "vpc-12345678",
@@ -25,13 +38,19 @@ func TestAIServicesModule(t *testing.T) {
 			"lambda_security_group_id": "sg-12345678",
 			"tags": map[string]string{
 				"Environment": "test",
-				"Project":     "test-ai-assistant",
+				"Project":     projectName,
 			},
 		},
 
 		// Disable colors in Terraform commands so it's easier to parse stdout/stderr
 		NoColor: true,
-	})
+
+		RetryableTerraformErrors: map[string]string{
+			"RequestError: send request failed": "Temporary AWS API error",
+		},
+		MaxRetries:         3,
+		TimeBetweenRetries: 5 * time.Second,
+	}
 
 	// Clean up resources with "terraform destroy" at the end of the test
 	defer terraform.Destroy(t, terraformOptions)
@@ -39,20 +58,26 @@ func TestAIServicesModule(t *testing.T) {
 	// Run "terraform init" and "terraform plan"
 	terraform.InitAndPlan(t, terraformOptions)
 
+	// Run the policy-as-code compliance suite against the plan before
+	// provisioning anything.
+	plan, err := compliance.ParsePlan(compliance.PlanJSON(t, terraformOptions))
+	require.NoError(t, err)
+	compliance.AssertCompliant(t, compliance.Evaluate(plan, compliance.DefaultRules))
+
 	// Run "terraform apply"
 	terraform.Apply(t, terraformOptions)
 
 	// Test outputs
-	testOutputs(t, terraformOptions)
+	testOutputs(t, terraformOptions, projectName)
 }
 
-func testOutputs(t *testing.T, terraformOptions *terraform.Options) {
+func testOutputs(t *testing.T, terraformOptions *terraform.Options, projectName string) {
 	// Test S3 bucket outputs
 	s3BucketName := terraform.Output(t, terraformOptions, "knowledge_base_s3_bucket_name")
-	assert.Contains(t, s3BucketName, "test-ai-assistant-test-knowledge-base-data")
+	assert.Contains(t, s3BucketName, projectName+"-test-knowledge-base-data")
 
 	s3BucketArn := terraform.Output(t, terraformOptions, "knowledge_base_s3_bucket_arn")
-	assert.Contains(t, s3BucketArn, "arn:aws:s3:::test-ai-assistant-test-knowledge-base-data")
+	assert.Contains(t, s3BucketArn, "arn:aws:s3:::"+projectName+"-test-knowledge-base-data")
 
 	// Test OpenSearch collection outputs
 	opensearchEndpoint := terraform.Output(t, terraformOptions, "opensearch_collection_endpoint")
@@ -64,11 +89,11 @@ func testOutputs(t *testing.T, terraformOptions *terraform.Options) {
 	// Test IAM role outputs
 	bedrockRoleArn := terraform.Output(t, terraformOptions, "bedrock_knowledge_base_role_arn")
 	assert.Contains(t, bedrockRoleArn, "arn:aws:iam::")
-	assert.Contains(t, bedrockRoleArn, "test-ai-assistant-test-bedrock-kb-role")
+	assert.Contains(t, bedrockRoleArn, projectName+"-test-bedrock-kb-role")
 
 	lambdaRoleArn := terraform.Output(t, terraformOptions, "lambda_bedrock_role_arn")
 	assert.Contains(t, lambdaRoleArn, "arn:aws:iam::")
-	assert.Contains(t, lambdaRoleArn, "test-ai-assistant-test-lambda-bedrock-role")
+	assert.Contains(t, lambdaRoleArn, projectName+"-test-lambda-bedrock-role")
 
 	// Test Knowledge Base outputs
 	knowledgeBaseId := terraform.Output(t, terraformOptions, "knowledge_base_id")
@@ -79,10 +104,10 @@ func testOutputs(t *testing.T, terraformOptions *terraform.Options) {
 
 	// Test CloudWatch log group outputs
 	bedrockLogGroup := terraform.Output(t, terraformOptions, "bedrock_api_log_group_name")
-	assert.Equal(t, "/aws/bedrock/test-ai-assistant-test", bedrockLogGroup)
+	assert.Equal(t, fmt.Sprintf("/aws/bedrock/%s-test", projectName), bedrockLogGroup)
 
 	kbLogGroup := terraform.Output(t, terraformOptions, "knowledge_base_log_group_name")
-	assert.Equal(t, "/aws/bedrock/knowledge-base/test-ai-assistant-test", kbLogGroup)
+	assert.Equal(t, fmt.Sprintf("/aws/bedrock/knowledge-base/%s-test", projectName), kbLogGroup)
 }
 
 func TestAIServicesModuleValidation(t *testing.T) {
@@ -111,10 +136,16 @@ func TestAIServicesModuleValidation(t *testing.T) {
 func TestAIServicesModuleWithCustomValues(t *testing.T) {
 	t.Parallel()
 
+	// Generate a random suffix so this run doesn't collide with other
+	// concurrent runs on globally-unique names (S3 bucket, OpenSearch
+	// collection).
+	uniqueID := random.UniqueId()
+	projectName := fmt.Sprintf("custom-ai-%s", strings.ToLower(uniqueID))
+
 	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
 		TerraformDir: "../",
 		Vars: map[string]interface{}{
-			"project_name":                      "custom-ai-assistant",
+			"project_name":                      projectName,
 			"environment":                       "staging",
 			"aws_region":                        "us-west-2",
 			"vpc_id":                            "vpc-87654321",
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/frodopackets/CLIve/terraform/test/validation"
+)
+
+// TestAIServicesModuleVariableValidation exercises the negative-validation
+// path of every constrained ai-services input variable, on top of
+// TestAIServicesModuleValidation's existing `environment` check.
+func TestAIServicesModuleVariableValidation(t *testing.T) {
+	t.Parallel()
+
+	baseVars := map[string]interface{}{
+		"project_name":             "test-ai-assistant",
+		"environment":              "test",
+		"aws_region":               "us-east-1",
+		"vpc_id":                   "vpc-12345678",
+		"private_subnet_ids":       []string{"subnet-12345678"},
+		"lambda_security_group_id": "sg-12345678",
+	}
+
+	validation.Run(t, "../", baseVars, []validation.Case{
+		{
+			Name:                   "ChunkSizeTooSmall",
+			VarOverrides:           map[string]interface{}{"knowledge_base_chunk_size": 1},
+			ExpectedErrorSubstring: "knowledge_base_chunk_size must be between 20 and 8192 tokens",
+		},
+		{
+			Name:                   "ChunkSizeTooLarge",
+			VarOverrides:           map[string]interface{}{"knowledge_base_chunk_size": 9000},
+			ExpectedErrorSubstring: "knowledge_base_chunk_size must be between 20 and 8192 tokens",
+		},
+		{
+			Name:                   "InvalidChunkingStrategy",
+			VarOverrides:           map[string]interface{}{"knowledge_base_chunking_strategy": "RANDOM_CHUNKS"},
+			ExpectedErrorSubstring: "knowledge_base_chunking_strategy must be one of",
+		},
+		{
+			Name:                   "MalformedVpcID",
+			VarOverrides:           map[string]interface{}{"vpc_id": "not-a-vpc-id"},
+			ExpectedErrorSubstring: "vpc_id must be a valid VPC identifier",
+		},
+		{
+			Name:                   "MalformedSubnetID",
+			VarOverrides:           map[string]interface{}{"private_subnet_ids": []string{"not-a-subnet-id"}},
+			ExpectedErrorSubstring: "private_subnet_ids must be a valid subnet identifier",
+		},
+		{
+			Name:                   "UnsupportedBedrockModel",
+			VarOverrides:           map[string]interface{}{"bedrock_models": []string{"not-a-real-model"}},
+			ExpectedErrorSubstring: "bedrock_models must be a valid Bedrock model ID",
+		},
+		{
+			Name:                   "NegativeLogRetentionDays",
+			VarOverrides:           map[string]interface{}{"log_retention_days": -1},
+			ExpectedErrorSubstring: "log_retention_days must be a positive number of days",
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package awsverify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertKnowledgeBaseEmbeddingModel calls bedrock-agent:GetKnowledgeBase and
+// asserts the knowledge base's vector configuration uses wantModelArn as
+// its embedding model.
+func AssertKnowledgeBaseEmbeddingModel(t *testing.T, region, knowledgeBaseID, wantModelArn string) {
+	t.Helper()
+
+	client := bedrockagent.NewFromConfig(newConfig(t, region))
+
+	out, err := client.GetKnowledgeBase(context.Background(), &bedrockagent.GetKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, out.KnowledgeBase)
+
+	vectorConfig, ok := out.KnowledgeBase.KnowledgeBaseConfiguration.(*types.KnowledgeBaseConfigurationMemberVectorKnowledgeBaseConfiguration)
+	require.True(t, ok, "knowledge base %s is not vector-configured", knowledgeBaseID)
+
+	assert.Equal(t, wantModelArn, aws.ToString(vectorConfig.Value.EmbeddingModelArn))
+}
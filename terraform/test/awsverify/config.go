@@ -0,0 +1,20 @@
+package awsverify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/stretchr/testify/require"
+)
+
+// newConfig loads the default AWS SDK v2 config for region, failing t if
+// credentials or region resolution fails.
+func newConfig(t *testing.T, region string) aws.Config {
+	t.Helper()
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(region))
+	require.NoError(t, err)
+	return cfg
+}
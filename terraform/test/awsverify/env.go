@@ -0,0 +1,28 @@
+// Package awsverify provides AWS SDK v2-backed assertions that confirm the
+// ai-services module's resources are actually configured correctly in a
+// real account, the same way terratest's aws.AssertS3BucketExists and
+// aws.GetS3BucketEncryption do for the frontend-hosting module.
+//
+// Every exported assertion here calls real AWS APIs against resources
+// created by an actual terraform.Apply, so callers must guard them with
+// RequireIntegrationMode.
+package awsverify
+
+import (
+	"os"
+	"testing"
+)
+
+// IntegrationModeEnvVar gates the awsverify helpers so they only run when a
+// human or CI pipeline has explicitly opted into real AWS integration
+// testing, since they provision billable resources and call live AWS APIs.
+const IntegrationModeEnvVar = "AI_ASSISTANT_AWS_INTEGRATION_TESTS"
+
+// RequireIntegrationMode skips t unless IntegrationModeEnvVar is set to
+// "true".
+func RequireIntegrationMode(t *testing.T) {
+	t.Helper()
+	if os.Getenv(IntegrationModeEnvVar) != "true" {
+		t.Skipf("skipping AWS integration assertions: set %s=true to run", IntegrationModeEnvVar)
+	}
+}
@@ -0,0 +1,46 @@
+package awsverify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertLambdaRoleCanInvokeModelsOnly simulates roleArn's effective IAM
+// policy via iam:SimulatePrincipalPolicy and asserts it is allowed to call
+// bedrock:InvokeModel against every ARN in allowedModelArns, but is denied
+// iam:* on "*".
+func AssertLambdaRoleCanInvokeModelsOnly(t *testing.T, region, roleArn string, allowedModelArns []string) {
+	t.Helper()
+
+	client := iam.NewFromConfig(newConfig(t, region))
+
+	invoke, err := client.SimulatePrincipalPolicy(context.Background(), &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(roleArn),
+		ActionNames:     []string{"bedrock:InvokeModel"},
+		ResourceArns:    allowedModelArns,
+	})
+	require.NoError(t, err)
+	for _, result := range invoke.EvaluationResults {
+		assert.Equal(t, types.PolicyEvaluationDecisionTypeAllowed, result.EvalDecision,
+			"expected %s to allow bedrock:InvokeModel on %s", roleArn, aws.ToString(result.EvalResourceName))
+	}
+
+	// ResourceArns is intentionally omitted: per the SDK's documentation it
+	// defaults to "*" (all resources) when not provided, and does not
+	// accept the literal string "*" as a resource ARN.
+	wildcard, err := client.SimulatePrincipalPolicy(context.Background(), &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(roleArn),
+		ActionNames:     []string{"iam:*"},
+	})
+	require.NoError(t, err)
+	for _, result := range wildcard.EvaluationResults {
+		assert.NotEqual(t, types.PolicyEvaluationDecisionTypeAllowed, result.EvalDecision,
+			"expected %s to be denied iam:*", roleArn)
+	}
+}
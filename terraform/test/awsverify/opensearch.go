@@ -0,0 +1,30 @@
+package awsverify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertOpenSearchCollectionActive calls
+// opensearchserverless:BatchGetCollection and asserts the named collection
+// is ACTIVE and of type VECTORSEARCH.
+func AssertOpenSearchCollectionActive(t *testing.T, region, collectionName string) {
+	t.Helper()
+
+	client := opensearchserverless.NewFromConfig(newConfig(t, region))
+
+	out, err := client.BatchGetCollection(context.Background(), &opensearchserverless.BatchGetCollectionInput{
+		Names: []string{collectionName},
+	})
+	require.NoError(t, err)
+	require.Len(t, out.CollectionDetails, 1, "expected exactly one collection named %s", collectionName)
+
+	collection := out.CollectionDetails[0]
+	assert.Equal(t, types.CollectionStatusActive, collection.Status)
+	assert.Equal(t, types.CollectionTypeVectorsearch, collection.Type)
+}
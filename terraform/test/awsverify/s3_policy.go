@@ -0,0 +1,81 @@
+package awsverify
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertS3BucketPolicyGrantsOnlyPrincipal fetches the bucket policy for
+// bucketName and asserts every Allow statement grants access only to
+// allowedRoleArn, e.g. the bedrock knowledge base role. It fails the test
+// on any Allow statement whose principal isn't an AWS principal at all
+// (e.g. a Service principal), rather than silently skipping it, since that
+// is exactly the kind of unexpected grant this check exists to catch.
+func AssertS3BucketPolicyGrantsOnlyPrincipal(t *testing.T, region, bucketName, allowedRoleArn string) {
+	t.Helper()
+
+	client := s3.NewFromConfig(newConfig(t, region))
+
+	out, err := client.GetBucketPolicy(context.Background(), &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+	})
+	require.NoError(t, err)
+
+	var policy struct {
+		Statement []struct {
+			Effect    string          `json:"Effect"`
+			Principal json.RawMessage `json:"Principal"`
+		} `json:"Statement"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(aws.ToString(out.Policy)), &policy))
+
+	for _, stmt := range policy.Statement {
+		if stmt.Effect != "Allow" {
+			continue
+		}
+
+		// `Principal` is either the bare string "*" or an object keyed by
+		// principal type (AWS, Service, Federated, ...).
+		var wildcard string
+		if err := json.Unmarshal(stmt.Principal, &wildcard); err == nil {
+			assert.Equal(t, allowedRoleArn, wildcard, "bucket %s grants access to an unexpected principal", bucketName)
+			continue
+		}
+
+		var principalTypes map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(stmt.Principal, &principalTypes), "bucket %s has an unparseable principal: %s", bucketName, stmt.Principal)
+
+		awsPrincipal, hasAWSOnly := principalTypes["AWS"]
+		if !assert.True(t, hasAWSOnly && len(principalTypes) == 1,
+			"bucket %s grants access to a non-AWS principal type: %s", bucketName, stmt.Principal) {
+			continue
+		}
+
+		for _, principal := range principalsOf(awsPrincipal) {
+			assert.Equal(t, allowedRoleArn, principal, "bucket %s grants access to an unexpected principal", bucketName)
+		}
+	}
+}
+
+// principalsOf normalizes an IAM policy statement's `Principal.AWS` field,
+// which the AWS API may render as either a single string or a list of
+// strings.
+func principalsOf(raw json.RawMessage) []string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package compliance
+
+import (
+	"fmt"
+	"testing"
+)
+
+// AssertCompliant fails t for every FAILURE-severity result that did not
+// pass. WARNING-severity results are logged but do not fail the test.
+func AssertCompliant(t *testing.T, results []Result) {
+	t.Helper()
+
+	for _, r := range results {
+		if r.Passed {
+			continue
+		}
+
+		msg := fmt.Sprintf("[%s] %s %s violates %q: %s", r.Severity, r.ResourceType, r.ResourceAddr, r.RuleName, r.Message)
+		if r.Severity == SeverityFailure {
+			t.Error(msg)
+		} else {
+			t.Log(msg)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package compliance
+
+import "testing"
+
+const samplePlanJSON = `{
+  "planned_values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_s3_bucket_server_side_encryption_configuration.good",
+          "type": "aws_s3_bucket_server_side_encryption_configuration",
+          "name": "good",
+          "values": {
+            "rule": [
+              {"apply_server_side_encryption_by_default": [{"sse_algorithm": "aws:kms"}]}
+            ]
+          }
+        },
+        {
+          "address": "aws_s3_bucket_server_side_encryption_configuration.bad",
+          "type": "aws_s3_bucket_server_side_encryption_configuration",
+          "name": "bad",
+          "values": {
+            "rule": [
+              {"apply_server_side_encryption_by_default": [{"sse_algorithm": "none"}]}
+            ]
+          }
+        },
+        {
+          "address": "aws_s3_bucket_public_access_block.good",
+          "type": "aws_s3_bucket_public_access_block",
+          "name": "good",
+          "values": {
+            "block_public_acls": true,
+            "block_public_policy": true,
+            "ignore_public_acls": true,
+            "restrict_public_buckets": true
+          }
+        },
+        {
+          "address": "aws_iam_role_policy.wildcard",
+          "type": "aws_iam_role_policy",
+          "name": "wildcard",
+          "values": {
+            "policy": "{\"Statement\":[{\"Effect\":\"Allow\",\"Action\":\"*\",\"Resource\":\"*\"}]}"
+          }
+        },
+        {
+          "address": "aws_cloudfront_distribution.no_waf",
+          "type": "aws_cloudfront_distribution",
+          "name": "no_waf",
+          "values": {
+            "default_cache_behavior": [{"viewer_protocol_policy": "redirect-to-https"}],
+            "web_acl_id": ""
+          }
+        },
+        {
+          "address": "aws_cloudfront_distribution.with_waf",
+          "type": "aws_cloudfront_distribution",
+          "name": "with_waf",
+          "values": {
+            "default_cache_behavior": [{"viewer_protocol_policy": "redirect-to-https"}],
+            "web_acl_id": "arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/abc123"
+          }
+        },
+        {
+          "address": "aws_cloudwatch_log_group.unencrypted",
+          "type": "aws_cloudwatch_log_group",
+          "name": "unencrypted",
+          "values": {
+            "retention_in_days": 30,
+            "kms_key_id": ""
+          }
+        },
+        {
+          "address": "aws_cloudwatch_log_group.encrypted",
+          "type": "aws_cloudwatch_log_group",
+          "name": "encrypted",
+          "values": {
+            "retention_in_days": 30,
+            "kms_key_id": "arn:aws:kms:us-east-1:123456789012:key/example"
+          }
+        }
+      ]
+    }
+  },
+  "resource_changes": []
+}`
+
+func TestEvaluateFlagsFailuresAndPasses(t *testing.T) {
+	plan, err := ParsePlan([]byte(samplePlanJSON))
+	if err != nil {
+		t.Fatalf("ParsePlan returned error: %v", err)
+	}
+
+	results := Evaluate(plan, DefaultRules)
+
+	byAddr := map[string]Result{}
+	for _, r := range results {
+		byAddr[r.ResourceAddr] = r
+	}
+
+	if r := byAddr["aws_s3_bucket_server_side_encryption_configuration.good"]; !r.Passed {
+		t.Errorf("expected KMS-encrypted bucket to pass, got: %s", r.Message)
+	}
+	if r := byAddr["aws_s3_bucket_server_side_encryption_configuration.bad"]; r.Passed {
+		t.Error("expected unencrypted bucket to fail the encryption rule")
+	}
+	if r := byAddr["aws_s3_bucket_public_access_block.good"]; !r.Passed {
+		t.Errorf("expected fully blocked bucket to pass, got: %s", r.Message)
+	}
+	if r := byAddr["aws_iam_role_policy.wildcard"]; r.Passed {
+		t.Error("expected wildcard action/resource policy to fail")
+	}
+	if r := byAddr["aws_cloudfront_distribution.no_waf"]; r.Passed {
+		t.Error("expected distribution with blank web_acl_id to fail the WAF rule")
+	}
+	if r := byAddr["aws_cloudfront_distribution.with_waf"]; !r.Passed {
+		t.Errorf("expected distribution with a WAF ACL attached to pass, got: %s", r.Message)
+	}
+	if r := byAddr["aws_cloudwatch_log_group.unencrypted"]; r.Passed {
+		t.Error("expected log group with blank kms_key_id to fail the encryption rule")
+	}
+	if r := byAddr["aws_cloudwatch_log_group.encrypted"]; !r.Passed {
+		t.Errorf("expected log group with a KMS key to pass, got: %s", r.Message)
+	}
+}
+
+func TestNonEmptyStringRejectsBlankAttribute(t *testing.T) {
+	assertion := NonEmptyString{Path: "web_acl_id"}
+
+	if ok, _ := assertion.Evaluate(map[string]interface{}{"web_acl_id": ""}); ok {
+		t.Error("expected blank string to fail NonEmptyString")
+	}
+	if ok, _ := assertion.Evaluate(map[string]interface{}{"web_acl_id": "arn:aws:wafv2:..."}); !ok {
+		t.Error("expected non-empty string to pass NonEmptyString")
+	}
+}
+
+func TestLookupResolvesNestedListIndices(t *testing.T) {
+	values := map[string]interface{}{
+		"rule": []interface{}{
+			map[string]interface{}{
+				"apply_server_side_encryption_by_default": []interface{}{
+					map[string]interface{}{"sse_algorithm": "AES256"},
+				},
+			},
+		},
+	}
+
+	v, ok := lookup(values, "rule.0.apply_server_side_encryption_by_default.0.sse_algorithm")
+	if !ok {
+		t.Fatal("expected lookup to resolve the path")
+	}
+	if v != "AES256" {
+		t.Errorf("got %v, want AES256", v)
+	}
+}
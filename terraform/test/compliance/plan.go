@@ -0,0 +1,100 @@
+// Package compliance implements lightweight policy-as-code checks against
+// Terraform plan JSON (the output of `terraform show -json`). Module tests
+// use it to assert baseline security/compliance invariants before calling
+// terraform.Apply, without depending on an external scanner.
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+)
+
+// Plan is the subset of `terraform show -json` output this package decodes:
+// the planned resource values and the proposed resource changes. Evaluate
+// checks only the planned resource values; ResourceChanges is decoded but
+// not evaluated.
+type Plan struct {
+	PlannedValues struct {
+		RootModule struct {
+			Resources    []Resource `json:"resources"`
+			ChildModules []struct {
+				Resources []Resource `json:"resources"`
+			} `json:"child_modules"`
+		} `json:"root_module"`
+	} `json:"planned_values"`
+	ResourceChanges []ResourceChange `json:"resource_changes"`
+}
+
+// Resource is a single planned resource instance and its attribute values.
+type Resource struct {
+	Address string                 `json:"address"`
+	Type    string                 `json:"type"`
+	Name    string                 `json:"name"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+// ResourceChange is a single entry of the plan's resource_changes array.
+type ResourceChange struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	Change  struct {
+		Actions []string               `json:"actions"`
+		After   map[string]interface{} `json:"after"`
+	} `json:"change"`
+}
+
+// ParsePlan decodes the JSON produced by `terraform show -json`.
+func ParsePlan(data []byte) (*Plan, error) {
+	var p Plan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("compliance: parsing plan json: %w", err)
+	}
+	return &p, nil
+}
+
+// Resources returns every planned resource in the root module and its
+// direct child modules. Resources of modules nested below those are not
+// included.
+func (p *Plan) Resources() []Resource {
+	all := append([]Resource{}, p.PlannedValues.RootModule.Resources...)
+	for _, m := range p.PlannedValues.RootModule.ChildModules {
+		all = append(all, m.Resources...)
+	}
+	return all
+}
+
+// ResourcesOfType filters Resources to those matching the given Terraform
+// resource type, e.g. "aws_s3_bucket_public_access_block".
+func (p *Plan) ResourcesOfType(resourceType string) []Resource {
+	var out []Resource
+	for _, r := range p.Resources() {
+		if r.Type == resourceType {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// PlanJSON runs `terraform plan -out=<tmp>` followed by `terraform show
+// -json <tmp>` against options and returns the raw plan JSON, ready to be
+// passed to ParsePlan. It is meant to be called in place of (or right
+// before) terraform.Apply.
+func PlanJSON(t *testing.T, options *terraform.Options) []byte {
+	t.Helper()
+
+	planFile := filepath.Join(t.TempDir(), "tfplan.binary")
+
+	terraform.RunTerraformCommand(t, options,
+		terraform.FormatArgs(options, "plan", "-input=false", "-out="+planFile)...)
+
+	// `terraform show` only accepts a limited set of flags and does not
+	// understand `-var`/`-var-file`, so it must not go through FormatArgs
+	// (which would append one `-var` per entry in options.Vars).
+	out := terraform.RunTerraformCommand(t, options, "show", "-no-color", "-json", planFile)
+
+	return []byte(out)
+}
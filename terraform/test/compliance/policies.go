@@ -0,0 +1,52 @@
+package compliance
+
+// DefaultRules is the built-in policy set module tests run against their
+// plan JSON before provisioning anything. It mirrors the baseline checks a
+// config-lint style external scanner would apply, so every module test
+// gets them for free.
+var DefaultRules = []Rule{
+	{
+		Name:         "s3-encryption-kms-or-aes256",
+		ResourceType: "aws_s3_bucket_server_side_encryption_configuration",
+		Severity:     SeverityFailure,
+		Assertion: Every{
+			Path:  "rule",
+			Inner: Regex{Path: "apply_server_side_encryption_by_default.0.sse_algorithm", Pattern: "^(aws:kms|AES256)$"},
+		},
+	},
+	{
+		Name:         "s3-public-access-fully-blocked",
+		ResourceType: "aws_s3_bucket_public_access_block",
+		Severity:     SeverityFailure,
+		Assertion: AllOf{Assertions: []Assertion{
+			IsTrue{Path: "block_public_acls"},
+			IsTrue{Path: "block_public_policy"},
+			IsTrue{Path: "ignore_public_acls"},
+			IsTrue{Path: "restrict_public_buckets"},
+		}},
+	},
+	{
+		Name:         "cloudfront-forces-https-behind-waf",
+		ResourceType: "aws_cloudfront_distribution",
+		Severity:     SeverityFailure,
+		Assertion: AllOf{Assertions: []Assertion{
+			Regex{Path: "default_cache_behavior.0.viewer_protocol_policy", Pattern: "^redirect-to-https$"},
+			NonEmptyString{Path: "web_acl_id"},
+		}},
+	},
+	{
+		Name:         "log-group-retention-and-encryption",
+		ResourceType: "aws_cloudwatch_log_group",
+		Severity:     SeverityFailure,
+		Assertion: AllOf{Assertions: []Assertion{
+			Present{Path: "retention_in_days"},
+			NonEmptyString{Path: "kms_key_id"},
+		}},
+	},
+	{
+		Name:         "iam-policy-no-wildcard-action-on-wildcard-resource",
+		ResourceType: "aws_iam_role_policy",
+		Severity:     SeverityFailure,
+		Assertion:    NoWildcardActions{Path: "policy"},
+	},
+}
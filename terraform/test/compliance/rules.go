@@ -0,0 +1,244 @@
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Severity classifies how a failed rule should be treated by callers.
+type Severity string
+
+const (
+	// SeverityFailure marks a rule whose violation must fail the test.
+	SeverityFailure Severity = "FAILURE"
+	// SeverityWarning marks a rule whose violation is reported but does
+	// not fail the test.
+	SeverityWarning Severity = "WARNING"
+)
+
+// Rule binds an Assertion to the resource type it applies to and the
+// severity to report when it fails.
+type Rule struct {
+	Name         string
+	ResourceType string
+	Severity     Severity
+	Assertion    Assertion
+}
+
+// Result is the outcome of evaluating a single Rule against a single
+// resource found in the plan.
+type Result struct {
+	RuleName     string
+	ResourceAddr string
+	ResourceType string
+	Severity     Severity
+	Passed       bool
+	Message      string
+}
+
+// Evaluate runs every rule against the matching resources in the plan and
+// returns one Result per (rule, resource) pair.
+func Evaluate(plan *Plan, rules []Rule) []Result {
+	var results []Result
+	for _, rule := range rules {
+		for _, r := range plan.ResourcesOfType(rule.ResourceType) {
+			passed, msg := rule.Assertion.Evaluate(r.Values)
+			results = append(results, Result{
+				RuleName:     rule.Name,
+				ResourceAddr: r.Address,
+				ResourceType: r.Type,
+				Severity:     rule.Severity,
+				Passed:       passed,
+				Message:      msg,
+			})
+		}
+	}
+	return results
+}
+
+// Assertion evaluates a boolean condition against a resource's attribute
+// values. It returns whether the condition held and, when it did not, a
+// human-readable explanation.
+type Assertion interface {
+	Evaluate(values map[string]interface{}) (bool, string)
+}
+
+// Present asserts that the attribute at Path is set (non-nil).
+type Present struct{ Path string }
+
+func (a Present) Evaluate(values map[string]interface{}) (bool, string) {
+	v, ok := lookup(values, a.Path)
+	if !ok || v == nil {
+		return false, fmt.Sprintf("%s is not set", a.Path)
+	}
+	return true, ""
+}
+
+// NonEmptyString asserts that the attribute at Path is a non-empty string.
+// Unlike Present, this catches the common Terraform case where an unset
+// optional string attribute is serialized as "" rather than null.
+type NonEmptyString struct{ Path string }
+
+func (a NonEmptyString) Evaluate(values map[string]interface{}) (bool, string) {
+	v, ok := lookup(values, a.Path)
+	s, isStr := v.(string)
+	if !ok || !isStr || s == "" {
+		return false, fmt.Sprintf("%s is blank", a.Path)
+	}
+	return true, ""
+}
+
+// IsTrue asserts that the attribute at Path is boolean true.
+type IsTrue struct{ Path string }
+
+func (a IsTrue) Evaluate(values map[string]interface{}) (bool, string) {
+	v, ok := lookup(values, a.Path)
+	b, isBool := v.(bool)
+	if !ok || !isBool || !b {
+		return false, fmt.Sprintf("%s is not true", a.Path)
+	}
+	return true, ""
+}
+
+// Regex asserts that the attribute at Path is a string matching Pattern.
+type Regex struct {
+	Path    string
+	Pattern string
+}
+
+func (a Regex) Evaluate(values map[string]interface{}) (bool, string) {
+	v, ok := lookup(values, a.Path)
+	s, isStr := v.(string)
+	if !ok || !isStr {
+		return false, fmt.Sprintf("%s is not a string", a.Path)
+	}
+	if matched, err := regexp.MatchString(a.Pattern, s); err != nil || !matched {
+		return false, fmt.Sprintf("%s value %q does not match %s", a.Path, s, a.Pattern)
+	}
+	return true, ""
+}
+
+// Every asserts that Inner holds for every element of the list at Path.
+type Every struct {
+	Path  string
+	Inner Assertion
+}
+
+func (a Every) Evaluate(values map[string]interface{}) (bool, string) {
+	v, ok := lookup(values, a.Path)
+	list, isList := v.([]interface{})
+	if !ok || !isList {
+		return false, fmt.Sprintf("%s is not a list", a.Path)
+	}
+	for i, item := range list {
+		elem, ok := item.(map[string]interface{})
+		if !ok {
+			return false, fmt.Sprintf("%s[%d] is not an object", a.Path, i)
+		}
+		if ok, msg := a.Inner.Evaluate(elem); !ok {
+			return false, fmt.Sprintf("%s[%d]: %s", a.Path, i, msg)
+		}
+	}
+	return true, ""
+}
+
+// AllOf passes only if every inner assertion holds.
+type AllOf struct{ Assertions []Assertion }
+
+func (a AllOf) Evaluate(values map[string]interface{}) (bool, string) {
+	for _, inner := range a.Assertions {
+		if ok, msg := inner.Evaluate(values); !ok {
+			return false, msg
+		}
+	}
+	return true, ""
+}
+
+// AnyOf passes if at least one inner assertion holds.
+type AnyOf struct{ Assertions []Assertion }
+
+func (a AnyOf) Evaluate(values map[string]interface{}) (bool, string) {
+	var msgs []string
+	for _, inner := range a.Assertions {
+		if ok, msg := inner.Evaluate(values); ok {
+			return true, ""
+		} else {
+			msgs = append(msgs, msg)
+		}
+	}
+	return false, strings.Join(msgs, "; or ")
+}
+
+// NoWildcardActions asserts that the IAM policy document JSON at Path does
+// not contain an Allow statement that grants a wildcard action ("*") on a
+// wildcard resource ("*").
+type NoWildcardActions struct{ Path string }
+
+func (a NoWildcardActions) Evaluate(values map[string]interface{}) (bool, string) {
+	v, ok := lookup(values, a.Path)
+	doc, isStr := v.(string)
+	if !ok || !isStr {
+		return false, fmt.Sprintf("%s is not a string", a.Path)
+	}
+
+	var policy struct {
+		Statement []struct {
+			Effect   string      `json:"Effect"`
+			Action   interface{} `json:"Action"`
+			Resource interface{} `json:"Resource"`
+		} `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(doc), &policy); err != nil {
+		return false, fmt.Sprintf("%s is not valid JSON: %v", a.Path, err)
+	}
+
+	for _, stmt := range policy.Statement {
+		if stmt.Effect == "Allow" && containsWildcard(stmt.Action) && containsWildcard(stmt.Resource) {
+			return false, fmt.Sprintf("%s grants a wildcard action on a wildcard resource", a.Path)
+		}
+	}
+	return true, ""
+}
+
+func containsWildcard(v interface{}) bool {
+	switch t := v.(type) {
+	case string:
+		return t == "*"
+	case []interface{}:
+		for _, item := range t {
+			if s, ok := item.(string); ok && s == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lookup resolves a dotted attribute path (e.g.
+// "default_cache_behavior.0.viewer_protocol_policy") against a resource's
+// values tree, descending through nested objects and list indices.
+func lookup(values map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = values
+	for _, part := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			v, ok := node[part]
+			if !ok {
+				return nil, false
+			}
+			current = v
+		case []interface{}:
+			idx, err := strconv.Atoi(part)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			current = node[idx]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
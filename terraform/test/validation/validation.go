@@ -0,0 +1,53 @@
+// Package validation provides a small table-driven harness for asserting
+// that a module rejects bad input variables at plan time, instead of
+// hand-rolling one terraform.InitAndPlanE/assert.Contains pair per case.
+package validation
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// Case is a single negative-validation scenario: applying VarOverrides on
+// top of a module's base variables is expected to fail `terraform plan`
+// with an error containing ExpectedErrorSubstring.
+type Case struct {
+	Name                   string
+	VarOverrides           map[string]interface{}
+	ExpectedErrorSubstring string
+}
+
+// Run executes every case against terraformDir as its own subtest, merging
+// the case's VarOverrides on top of baseVars and asserting that
+// terraform.InitAndPlanE fails with the expected error substring.
+func Run(t *testing.T, terraformDir string, baseVars map[string]interface{}, cases []Case) {
+	t.Helper()
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			vars := make(map[string]interface{}, len(baseVars)+len(c.VarOverrides))
+			for k, v := range baseVars {
+				vars[k] = v
+			}
+			for k, v := range c.VarOverrides {
+				vars[k] = v
+			}
+
+			terraformOptions := &terraform.Options{
+				TerraformDir: terraformDir,
+				Vars:         vars,
+				NoColor:      true,
+			}
+
+			_, err := terraform.InitAndPlanE(t, terraformOptions)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), c.ExpectedErrorSubstring)
+			}
+		})
+	}
+}
@@ -1,16 +1,24 @@
 package test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/gruntwork-io/terratest/modules/aws"
+	http_helper "github.com/gruntwork-io/terratest/modules/http-helper"
 	"github.com/gruntwork-io/terratest/modules/random"
 	"github.com/gruntwork-io/terratest/modules/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/frodopackets/CLIve/terraform/test/compliance"
 )
 
 // TestFrontendHostingModule tests the frontend hosting module
@@ -52,8 +60,17 @@ func TestFrontendHostingModule(t *testing.T) {
 	// Clean up resources after test
 	defer terraform.Destroy(t, terraformOptions)
 
-	// Deploy the infrastructure
-	terraform.InitAndApply(t, terraformOptions)
+	// Run "terraform init" and "terraform plan"
+	terraform.InitAndPlan(t, terraformOptions)
+
+	// Run the policy-as-code compliance suite against the plan before
+	// provisioning anything.
+	plan, err := compliance.ParsePlan(compliance.PlanJSON(t, terraformOptions))
+	require.NoError(t, err)
+	compliance.AssertCompliant(t, compliance.Evaluate(plan, compliance.DefaultRules))
+
+	// Run "terraform apply"
+	terraform.Apply(t, terraformOptions)
 
 	// Test S3 bucket creation
 	t.Run("S3BucketExists", func(t *testing.T) {
@@ -120,6 +137,90 @@ func TestFrontendHostingModule(t *testing.T) {
 		assert.NotEmpty(t, encryption.Rules)
 		assert.Equal(t, "AES256", *encryption.Rules[0].ApplyServerSideEncryptionByDefault.SSEAlgorithm)
 	})
+
+	// Test the distribution end-to-end: upload content to the origin
+	// bucket, then confirm CloudFront actually serves it over HTTPS with
+	// the OAC/OAI, default root object, and error-response mapping wired
+	// up correctly.
+	t.Run("EndToEndHTTPBehavior", func(t *testing.T) {
+		bucketName := terraform.Output(t, terraformOptions, "s3_bucket_name")
+		domainName := terraform.Output(t, terraformOptions, "cloudfront_domain_name")
+		distributionURL := fmt.Sprintf("https://%s", domainName)
+		const indexBody = "<html><body>hello from terratest</body></html>"
+		const testTextBody = "plain text object served directly from the origin bucket"
+
+		putS3Object(t, awsRegion, bucketName, "index.html", "text/html", indexBody)
+		putS3Object(t, awsRegion, bucketName, "test.txt", "text/plain", testTextBody)
+
+		t.Run("ServesUploadedContent", func(t *testing.T) {
+			http_helper.HttpGetWithRetryWithCustomValidation(
+				t, distributionURL, nil, 30, 10*time.Second,
+				func(statusCode int, body string) bool {
+					return statusCode == http.StatusOK && strings.Contains(body, "hello from terratest")
+				},
+			)
+		})
+
+		t.Run("ServesPlainObjectPassthrough", func(t *testing.T) {
+			http_helper.HttpGetWithRetryWithCustomValidation(
+				t, distributionURL+"/test.txt", nil, 10, 5*time.Second,
+				func(statusCode int, body string) bool {
+					return statusCode == http.StatusOK && strings.TrimSpace(body) == testTextBody
+				},
+			)
+		})
+
+		t.Run("RedirectsHTTPToHTTPS", func(t *testing.T) {
+			client := &http.Client{
+				CheckRedirect: func(req *http.Request, via []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}
+
+			resp, err := client.Get(fmt.Sprintf("http://%s", domainName))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Contains(t, []int{http.StatusMovedPermanently, http.StatusFound}, resp.StatusCode)
+			assert.True(t, strings.HasPrefix(resp.Header.Get("Location"), "https://"))
+		})
+
+		t.Run("NonExistentPathServesSPAFallback", func(t *testing.T) {
+			http_helper.HttpGetWithRetryWithCustomValidation(
+				t, distributionURL+"/some/nonexistent/route", nil, 10, 5*time.Second,
+				func(statusCode int, body string) bool {
+					return statusCode == http.StatusOK && strings.Contains(body, "hello from terratest")
+				},
+			)
+		})
+
+		t.Run("SecurityResponseHeadersPresent", func(t *testing.T) {
+			resp, err := http.Get(distributionURL)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.NotEmpty(t, resp.Header.Get("Strict-Transport-Security"))
+			assert.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+		})
+	})
+}
+
+// putS3Object uploads a small object to the origin bucket so end-to-end
+// HTTP tests have real content to fetch back through CloudFront.
+func putS3Object(t *testing.T, region, bucket, key, contentType, body string) {
+	t.Helper()
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(region))
+	require.NoError(t, err)
+
+	client := s3.NewFromConfig(cfg)
+	_, err = client.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket:      awssdk.String(bucket),
+		Key:         awssdk.String(key),
+		Body:        strings.NewReader(body),
+		ContentType: awssdk.String(contentType),
+	})
+	require.NoError(t, err)
 }
 
 // TestFrontendHostingModuleWithPipeline tests the frontend hosting module with deployment pipeline enabled
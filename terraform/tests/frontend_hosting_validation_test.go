@@ -0,0 +1,29 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/frodopackets/CLIve/terraform/test/validation"
+)
+
+// TestFrontendHostingModuleVariableValidation exercises the
+// negative-validation path of the frontend-hosting module's constrained
+// input variables.
+func TestFrontendHostingModuleVariableValidation(t *testing.T) {
+	t.Parallel()
+
+	baseVars := map[string]interface{}{
+		"project_name":     "test-ai-cli",
+		"environment":      "test",
+		"aws_region":       "us-east-1",
+		"s3_force_destroy": true,
+	}
+
+	validation.Run(t, "../modules/frontend-hosting", baseVars, []validation.Case{
+		{
+			Name:                   "InvalidCloudFrontPriceClass",
+			VarOverrides:           map[string]interface{}{"cloudfront_price_class": "PriceClass_Ultra"},
+			ExpectedErrorSubstring: "cloudfront_price_class must be one of",
+		},
+	})
+}